@@ -0,0 +1,103 @@
+package retry
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+)
+
+// Adaptive is a TCP-style AIMD backoff: each reported failure grows the
+// delay multiplicatively by Factor, and each reported success shrinks it by
+// half, clamped to [Min, Max].  It is driven by AttemptWithFeedback, which
+// reports attempt outcomes back to it; Delay alone just returns the current
+// delay without changing it.
+type Adaptive struct {
+	Min, Max time.Duration
+	Factor   float64 // multiplicative growth applied on failure, e.g. 2.0
+
+	mu  sync.Mutex
+	cur time.Duration
+}
+
+// NewAdaptive returns an Adaptive starting at min.
+func NewAdaptive(min, max time.Duration, factor float64) *Adaptive {
+	return &Adaptive{Min: min, Max: max, Factor: factor, cur: min}
+}
+
+func (a *Adaptive) Delay(n int) time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.cur
+}
+
+// Reset returns the delay to Min, satisfying the Resetter interface.
+func (a *Adaptive) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.cur = a.Min
+}
+
+// Report grows the delay on failure and shrinks it on success, satisfying
+// the Feedbacker interface.
+func (a *Adaptive) Report(success bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if success {
+		a.cur /= 2
+		if a.cur < a.Min {
+			a.cur = a.Min
+		}
+		return
+	}
+
+	a.cur = time.Duration(float64(a.cur) * a.Factor)
+	if a.Max > 0 && a.cur > a.Max {
+		a.cur = a.Max
+	}
+}
+
+// Feedbacker is implemented by Backoff strategies, such as Adaptive, that
+// adjust their future delays based on attempt outcomes reported through
+// AttemptWithFeedback.
+type Feedbacker interface {
+	Report(success bool)
+}
+
+// Feedback carries the delay for an attempt yielded by AttemptWithFeedback,
+// along with a Report function the caller invokes with the attempt's
+// outcome.  Report is a no-op unless the underlying Backoff implements
+// Feedbacker.
+type Feedback struct {
+	Delay  time.Duration
+	Report func(success bool)
+}
+
+// AttemptWithFeedback is like Attempt, but additionally yields a Report
+// function the caller calls with the outcome of each attempt, so adaptive
+// strategies such as Adaptive can grow the delay on failure and shrink it
+// on success.
+//
+// Example:
+//
+//	b := retry.NewAdaptive(100*time.Millisecond, 5*time.Second, 2)
+//	for i, fb := range retry.AttemptWithFeedback(ctx, b) {
+//	    err := doSomething()
+//	    fb.Report(err == nil)
+//	    if err == nil {
+//	        break
+//	    }
+//	}
+func AttemptWithFeedback(ctx context.Context, b Backoff) iter.Seq2[int, Feedback] {
+	report := func(bool) {}
+	if fb, ok := b.(Feedbacker); ok {
+		report = fb.Report
+	}
+
+	return func(yield func(int, Feedback) bool) {
+		attemptLoop(ctx, b, func(i int, d time.Duration) bool {
+			return yield(i, Feedback{Delay: d, Report: report})
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package retry
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestAdaptive(t *testing.T) {
+	a := NewAdaptive(time.Second, 16*time.Second, 2)
+
+	if got, expected := a.Delay(0), time.Second; got != expected {
+		t.Fatalf("got initial delay %s; expected %s", got, expected)
+	}
+
+	a.Report(false)
+	if got, expected := a.Delay(0), 2*time.Second; got != expected {
+		t.Fatalf("got delay after failure %s; expected %s", got, expected)
+	}
+
+	a.Report(false)
+	a.Report(false)
+	if got, expected := a.Delay(0), 8*time.Second; got != expected {
+		t.Fatalf("got delay after failures %s; expected %s", got, expected)
+	}
+
+	a.Report(true)
+	if got, expected := a.Delay(0), 4*time.Second; got != expected {
+		t.Fatalf("got delay after success %s; expected %s", got, expected)
+	}
+}
+
+func TestAdaptiveClampsToMax(t *testing.T) {
+	a := NewAdaptive(time.Second, 4*time.Second, 2)
+
+	for range 5 {
+		a.Report(false)
+	}
+
+	if got, expected := a.Delay(0), 4*time.Second; got != expected {
+		t.Fatalf("got delay %s; expected it clamped to %s", got, expected)
+	}
+}
+
+func TestAdaptiveReset(t *testing.T) {
+	a := NewAdaptive(time.Second, 16*time.Second, 2)
+	a.Report(false)
+	a.Report(false)
+
+	a.Reset()
+	if got, expected := a.Delay(0), time.Second; got != expected {
+		t.Fatalf("got delay %s after Reset; expected %s", got, expected)
+	}
+}
+
+func TestAttemptWithFeedback(t *testing.T) {
+	a := NewAdaptive(time.Microsecond, 100*time.Microsecond, 2)
+
+	var delays []time.Duration
+	for i, fb := range AttemptWithFeedback(t.Context(), a) {
+		delays = append(delays, fb.Delay)
+		fb.Report(false)
+		if i == 2 {
+			break
+		}
+	}
+
+	expected := []time.Duration{time.Microsecond, 2 * time.Microsecond, 4 * time.Microsecond}
+	if !eq(delays, expected) {
+		t.Fatalf("got delays %#v; expected %#v", delays, expected)
+	}
+}
+
+func TestAttemptResetsStatefulBackoff(t *testing.T) {
+	d := NewDecorrelatedJitter(time.Second, 30*time.Second, rand.NewSource(1))
+
+	first := func() time.Duration {
+		for _, delay := range Attempt(t.Context(), d) {
+			return delay
+		}
+		return 0
+	}
+
+	_ = first()
+	d.Delay(1) // advance state so prev != Base
+	second := first()
+
+	if second < time.Second || second > 3*time.Second {
+		t.Fatalf("got delay %s after reuse; expected Attempt to reset prev to Base first", second)
+	}
+}
+
+func TestBoundedForwardsResetToInnerResetter(t *testing.T) {
+	d := NewDecorrelatedJitter(time.Second, 30*time.Second, rand.NewSource(1))
+	b := &Bounded{B: d, MaxAttempts: 1}
+
+	first := func() time.Duration {
+		for _, delay := range Attempt(t.Context(), b) {
+			return delay
+		}
+		return 0
+	}
+
+	_ = first()
+	d.Delay(1) // advance state so prev != Base
+	second := first()
+
+	if second < time.Second || second > 3*time.Second {
+		t.Fatalf("got delay %s after reuse through Bounded; expected Bounded.Reset to forward to the wrapped DecorrelatedJitter", second)
+	}
+}
@@ -0,0 +1,120 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// PermanentError wraps an error to signal Do that it must stop retrying and
+// return immediately instead of continuing to back off.
+type PermanentError struct {
+	err error
+}
+
+func (p *PermanentError) Error() string { return p.err.Error() }
+
+func (p *PermanentError) Unwrap() error { return p.err }
+
+// Permanent wraps err so that Do returns it immediately instead of
+// retrying, regardless of WithRetryIf.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{err: err}
+}
+
+type options struct {
+	maxAttempts int
+	maxElapsed  time.Duration
+	retryIf     func(error) bool
+	onRetry     func(attempt int, err error, next time.Duration)
+}
+
+// Option configures a call to Do.
+type Option func(*options)
+
+// WithMaxAttempts stops Do after n attempts.  0, the default, means
+// unlimited.
+func WithMaxAttempts(n int) Option {
+	return func(o *options) { o.maxAttempts = n }
+}
+
+// WithMaxElapsed stops Do once d has elapsed since the first attempt.  0,
+// the default, means unlimited.
+func WithMaxElapsed(d time.Duration) Option {
+	return func(o *options) { o.maxElapsed = d }
+}
+
+// WithRetryIf overrides which errors are retried.  By default every
+// non-nil error is retried; returning false stops Do and returns that
+// error immediately, the same as wrapping it with Permanent.
+func WithRetryIf(f func(error) bool) Option {
+	return func(o *options) { o.retryIf = f }
+}
+
+// WithOnRetry registers a callback invoked after each failed attempt whose
+// error is retryable, with the delay Do would wait before the next attempt,
+// useful for logging or metrics.  It is also invoked for the last attempt
+// WithMaxAttempts permits, after which Do returns without waiting.
+func WithOnRetry(f func(attempt int, err error, next time.Duration)) Option {
+	return func(o *options) { o.onRetry = f }
+}
+
+// Do runs op, retrying on error according to b and opts, until op succeeds,
+// a Permanent error is returned, WithRetryIf rejects the error, an attempt
+// or elapsed-time limit is reached, or ctx is cancelled.  On success it
+// returns op's value and a nil error; otherwise it returns the zero value of
+// T with op's last error, or with ctx.Err() if ctx was cancelled while
+// waiting between attempts.
+func Do[T any](ctx context.Context, b Backoff, op func(ctx context.Context, attempt int) (T, error), opts ...Option) (T, error) {
+	cfg := options{retryIf: func(error) bool { return true }}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.maxAttempts > 0 || cfg.maxElapsed > 0 {
+		b = &Bounded{B: b, MaxAttempts: cfg.maxAttempts, MaxElapsed: cfg.maxElapsed}
+	}
+
+	start := time.Now()
+
+	var (
+		zero T
+		val  T
+		err  error
+	)
+	for attempt, delay := range Attempt(ctx, b) {
+		val, err = op(ctx, attempt)
+		if err == nil {
+			return val, nil
+		}
+
+		var perm *PermanentError
+		if errors.As(err, &perm) {
+			return zero, perm.Unwrap()
+		}
+		if !cfg.retryIf(err) {
+			return zero, err
+		}
+		if cfg.maxElapsed > 0 && time.Since(start) > cfg.maxElapsed {
+			return zero, err
+		}
+		if cfg.onRetry != nil {
+			cfg.onRetry(attempt, err, delay)
+		}
+		if cfg.maxAttempts > 0 && attempt+1 >= cfg.maxAttempts {
+			// This was the last permitted attempt: return now instead of
+			// falling through to Attempt's post-yield sleep, which would
+			// otherwise waste a full backoff interval before Bounded's
+			// Stop condition is checked on the next (never-taken) iteration.
+			return zero, err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return zero, ctx.Err()
+	}
+	return zero, err
+}
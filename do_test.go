@@ -0,0 +1,116 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDoSucceedsEventually(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	attempts := 0
+
+	got, err := Do(t.Context(), Constant(time.Microsecond), func(ctx context.Context, attempt int) (int, error) {
+		attempts++
+		if attempt < 2 {
+			return 0, errFlaky
+		}
+		return 42, nil
+	})
+
+	if err != nil {
+		t.Fatalf("got error %v; expected nil", err)
+	}
+	if got != 42 {
+		t.Fatalf("got %d; expected 42", got)
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts; expected 3", attempts)
+	}
+}
+
+func TestDoPermanentStopsImmediately(t *testing.T) {
+	errFatal := errors.New("fatal")
+	attempts := 0
+
+	_, err := Do(t.Context(), Constant(time.Microsecond), func(ctx context.Context, attempt int) (int, error) {
+		attempts++
+		return 0, Permanent(errFatal)
+	})
+
+	if !errors.Is(err, errFatal) {
+		t.Fatalf("got error %v; expected %v", err, errFatal)
+	}
+	if attempts != 1 {
+		t.Fatalf("got %d attempts; expected 1", attempts)
+	}
+}
+
+func TestDoRetryIfStopsRetrying(t *testing.T) {
+	errFatal := errors.New("fatal")
+	attempts := 0
+
+	_, err := Do(t.Context(), Constant(time.Microsecond), func(ctx context.Context, attempt int) (int, error) {
+		attempts++
+		return 0, errFatal
+	}, WithRetryIf(func(err error) bool { return false }))
+
+	if !errors.Is(err, errFatal) {
+		t.Fatalf("got error %v; expected %v", err, errFatal)
+	}
+	if attempts != 1 {
+		t.Fatalf("got %d attempts; expected 1", attempts)
+	}
+}
+
+func TestDoMaxAttempts(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	attempts := 0
+
+	_, err := Do(t.Context(), Constant(time.Microsecond), func(ctx context.Context, attempt int) (int, error) {
+		attempts++
+		return 0, errFlaky
+	}, WithMaxAttempts(3))
+
+	if !errors.Is(err, errFlaky) {
+		t.Fatalf("got error %v; expected %v", err, errFlaky)
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts; expected 3", attempts)
+	}
+}
+
+func TestDoMaxAttemptsDoesNotSleepAfterLastAttempt(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	start := time.Now()
+	_, err := Do(t.Context(), Constant(500*time.Millisecond), func(ctx context.Context, attempt int) (int, error) {
+		return 0, errFlaky
+	}, WithMaxAttempts(3))
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, errFlaky) {
+		t.Fatalf("got error %v; expected %v", err, errFlaky)
+	}
+	// 3 attempts means 2 sleeps between them (~1s), not 3 (~1.5s): Do must
+	// not sleep through the backoff after its last permitted attempt fails.
+	if elapsed >= 1250*time.Millisecond {
+		t.Fatalf("got elapsed %s; expected under ~1.25s (2 sleeps), not ~1.5s (3 sleeps)", elapsed)
+	}
+}
+
+func TestDoOnRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	var seen []int
+
+	_, _ = Do(t.Context(), Constant(time.Microsecond), func(ctx context.Context, attempt int) (int, error) {
+		return 0, errFlaky
+	}, WithMaxAttempts(2), WithOnRetry(func(attempt int, err error, next time.Duration) {
+		seen = append(seen, attempt)
+	}))
+
+	if !eq(seen, []int{0, 1}) {
+		t.Fatalf("got onRetry calls %#v; expected %#v", seen, []int{0, 1})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"iter"
 	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -22,18 +23,170 @@ func (d *Decay) Delay(n int) time.Duration {
 	return d.I >> (n / d.H)
 }
 
-// Jitter is a backoff strategy the augments other staegies by adding random
-// jitter to the Delay() result.
+// lockedSource wraps a rand.Source with a mutex so a single rand.Rand can
+// be shared safely by the jitter strategies below across concurrent Delay
+// calls, the same trick math/rand's own global source uses.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+// int63n returns a random int64 in [0, n) from rnd, or from the global
+// math/rand source if rnd is nil.  This keeps the jitter types below safe
+// to construct as zero-value/partial struct literals, the same convention
+// every other Backoff in this file follows, falling back to global
+// math/rand only for literals built without their New* constructor.
+func int63n(rnd *rand.Rand, n int64) int64 {
+	if rnd != nil {
+		return rnd.Int63n(n)
+	}
+	return rand.Int63n(n)
+}
+
+// CenteredJitter is a backoff strategy that augments another strategy by
+// adding random jitter to its Delay() result.
 //
-// The formula for jitter applied is ±(j/2) where j is a random number between
-// 0 and J.
-type Jitter struct {
+// The formula for jitter applied is ±(J/2) where the random component is
+// uniform in [0, J).
+//
+// Struct literals built without NewCenteredJitter work, given a non-nil B,
+// but draw from the contended, non-deterministic global math/rand source;
+// use NewCenteredJitter for a seedable, independent source.
+type CenteredJitter struct {
 	J time.Duration // Amount of jitter to apply.
 	B Backoff       // Backoff strategy to apply jitter to.
+
+	rnd *rand.Rand
+}
+
+// NewCenteredJitter returns a CenteredJitter whose randomness comes from
+// src, so it can be seeded for reproducible tests instead of contending on
+// the global math/rand source.
+func NewCenteredJitter(b Backoff, j time.Duration, src rand.Source) *CenteredJitter {
+	return &CenteredJitter{J: j, B: b, rnd: rand.New(&lockedSource{src: src})}
+}
+
+func (j *CenteredJitter) Delay(n int) time.Duration {
+	if j.J <= 0 {
+		return j.B.Delay(n)
+	}
+	return j.B.Delay(n) + time.Duration(int63n(j.rnd, int64(j.J))) - j.J/2
+}
+
+// FullJitter is the "full jitter" strategy from AWS's Exponential Backoff
+// and Jitter taxonomy: a delay uniform in [0, B.Delay(n)).
+//
+// Struct literals built without NewFullJitter work, given a non-nil B, but
+// draw from the contended, non-deterministic global math/rand source; use
+// NewFullJitter for a seedable, independent source.
+type FullJitter struct {
+	B Backoff
+
+	rnd *rand.Rand
+}
+
+// NewFullJitter returns a FullJitter whose randomness comes from src.
+func NewFullJitter(b Backoff, src rand.Source) *FullJitter {
+	return &FullJitter{B: b, rnd: rand.New(&lockedSource{src: src})}
+}
+
+func (j *FullJitter) Delay(n int) time.Duration {
+	d := j.B.Delay(n)
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(int63n(j.rnd, int64(d)))
+}
+
+// EqualJitter is the "equal jitter" strategy from AWS's Exponential Backoff
+// and Jitter taxonomy: half of B.Delay(n) plus a uniform random component
+// in [0, B.Delay(n)/2).
+//
+// Struct literals built without NewEqualJitter work, given a non-nil B, but
+// draw from the contended, non-deterministic global math/rand source; use
+// NewEqualJitter for a seedable, independent source.
+type EqualJitter struct {
+	B Backoff
+
+	rnd *rand.Rand
 }
 
-func (j *Jitter) Delay(n int) time.Duration {
-	return j.B.Delay(n) + time.Duration(rand.Int63n(int64(j.J))) - j.J/2
+// NewEqualJitter returns an EqualJitter whose randomness comes from src.
+func NewEqualJitter(b Backoff, src rand.Source) *EqualJitter {
+	return &EqualJitter{B: b, rnd: rand.New(&lockedSource{src: src})}
+}
+
+func (j *EqualJitter) Delay(n int) time.Duration {
+	d := j.B.Delay(n)
+	half := d / 2
+	if half <= 0 {
+		return half
+	}
+	return half + time.Duration(int63n(j.rnd, int64(half)))
+}
+
+// DecorrelatedJitter is the "decorrelated jitter" strategy from AWS's
+// Exponential Backoff and Jitter taxonomy: each delay is uniform in
+// [Base, prev*3), clamped to Cap, where prev is the delay returned by the
+// previous call.
+//
+// Because it carries prev across Delay calls, a DecorrelatedJitter must not
+// be shared across concurrent retry loops; its mutex only keeps that shared
+// state race-free, it does not make the resulting sequence of delays
+// meaningful once two loops interleave calls to it.
+//
+// The zero value and struct literals built without NewDecorrelatedJitter
+// work, but draw from the contended, non-deterministic global math/rand
+// source; use NewDecorrelatedJitter for a seedable, independent source.
+type DecorrelatedJitter struct {
+	Base time.Duration
+	Cap  time.Duration
+
+	mu   sync.Mutex
+	prev time.Duration
+	rnd  *rand.Rand
+}
+
+// NewDecorrelatedJitter returns a DecorrelatedJitter whose randomness comes
+// from src, with prev initialized to base.
+func NewDecorrelatedJitter(base, capDelay time.Duration, src rand.Source) *DecorrelatedJitter {
+	return &DecorrelatedJitter{Base: base, Cap: capDelay, prev: base, rnd: rand.New(&lockedSource{src: src})}
+}
+
+// Reset returns prev to Base, satisfying the Resetter interface so a
+// DecorrelatedJitter can be safely reused by a new Attempt loop.
+func (d *DecorrelatedJitter) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.prev = d.Base
+}
+
+func (d *DecorrelatedJitter) Delay(n int) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	hi := d.prev * 3
+	if hi <= d.Base {
+		hi = d.Base + 1
+	}
+	next := d.Base + time.Duration(int63n(d.rnd, int64(hi-d.Base)))
+	if d.Cap > 0 && next > d.Cap {
+		next = d.Cap
+	}
+	d.prev = next
+	return next
 }
 
 // Exponential encodes the amount to back off exponentially.  Its value is
@@ -58,6 +211,136 @@ func (l Linear) Delay(n int) time.Duration {
 	return time.Duration(l) * time.Duration(n+1)
 }
 
+// Polynomial evaluates a user-supplied polynomial to compute the delay for
+// each iteration.  Coefs holds the coefficients c0, c1, ..., ck of
+// c0 + c1*n + c2*n^2 + ... + ck*n^k, and Units scales the result to a
+// time.Duration.  An empty Coefs yields a 0 delay on every iteration.
+//
+// For example, Polynomial{Units: time.Second, Coefs: []float64{0.5, 2, 3}}
+// yields delays of 0.5s, 5.5s, 16.5s, ...
+type Polynomial struct {
+	Units time.Duration
+	Coefs []float64
+}
+
+func (p *Polynomial) Delay(n int) time.Duration {
+	if len(p.Coefs) == 0 {
+		return 0
+	}
+
+	var sum float64
+	x := 1.0
+	for _, c := range p.Coefs {
+		sum += c * x
+		x *= float64(n)
+	}
+
+	return time.Duration(sum * float64(p.Units))
+}
+
+// Bounded decorates another Backoff, clamping its Delay into [Min, Max] and
+// stopping an Attempt loop once MaxAttempts attempts or MaxElapsed wall-clock
+// time have been used, giving Attempt callers the same internal stop
+// conditions Do exposes through WithMaxAttempts/WithMaxElapsed.  A Min or
+// Max of 0 leaves that side of the clamp disabled; a MaxAttempts or
+// MaxElapsed of 0 means unlimited.
+//
+// MaxElapsed is measured from the first Stop call, so reusing a Bounded
+// across multiple Attempt loops relies on Attempt calling Reset before the
+// first yield of each loop, the same as any other stateful Backoff.
+type Bounded struct {
+	B           Backoff
+	Min, Max    time.Duration
+	MaxAttempts int
+	MaxElapsed  time.Duration
+
+	mu    sync.Mutex
+	start time.Time
+}
+
+func (b *Bounded) Delay(n int) time.Duration {
+	d := b.B.Delay(n)
+	if b.Min > 0 && d < b.Min {
+		d = b.Min
+	}
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	return d
+}
+
+// Stop reports whether Attempt should halt before computing the delay for
+// iteration n, satisfying the Stopper interface.  It also consults B, so
+// wrapping a Stopper in Bounded doesn't mask its own stop condition.
+func (b *Bounded) Stop(n int) bool {
+	if b.MaxAttempts > 0 && n >= b.MaxAttempts {
+		return true
+	}
+	if b.MaxElapsed > 0 {
+		b.mu.Lock()
+		if b.start.IsZero() {
+			b.start = time.Now()
+		}
+		elapsed := time.Since(b.start)
+		b.mu.Unlock()
+		if elapsed > b.MaxElapsed {
+			return true
+		}
+	}
+	s, ok := b.B.(Stopper)
+	return ok && s.Stop(n)
+}
+
+// Reset clears the MaxElapsed clock and forwards to B if it implements
+// Resetter, satisfying the Resetter interface so wrapping a stateful
+// strategy like DecorrelatedJitter or Adaptive in Bounded (as Do's
+// WithMaxAttempts does) doesn't defeat the reset-before-first-yield
+// contract Attempt relies on.
+func (b *Bounded) Reset() {
+	b.mu.Lock()
+	b.start = time.Time{}
+	b.mu.Unlock()
+	if r, ok := b.B.(Resetter); ok {
+		r.Reset()
+	}
+}
+
+// Stopper is implemented by Backoff strategies that know when to halt an
+// Attempt loop on their own, independent of context cancellation or a 0
+// delay.  Attempt checks for it before computing each iteration's delay.
+type Stopper interface {
+	Stop(n int) bool
+}
+
+// Resetter is implemented by stateful Backoff strategies, such as
+// DecorrelatedJitter or Adaptive, that carry state across Delay calls and
+// so must be returned to their initial state before being reused by a new
+// Attempt loop.  Attempt calls Reset before yielding its first attempt.
+type Resetter interface {
+	Reset()
+}
+
+// Config holds YAML- and flag-friendly settings for the common case of an
+// Exponential backoff bounded between a minimum and maximum delay with a
+// limited number of retries.
+type Config struct {
+	MinBackoff time.Duration `yaml:"min_backoff"`
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+	MaxRetries int           `yaml:"max_retries"`
+}
+
+// Backoff builds the Bounded(Exponential) strategy described by c, the
+// one-liner replacement for most call sites that previously clamped
+// Exponential by hand.
+func (c Config) Backoff() Backoff {
+	return &Bounded{
+		B:           Exponential(c.MinBackoff),
+		Min:         c.MinBackoff,
+		Max:         c.MaxBackoff,
+		MaxAttempts: c.MaxRetries,
+	}
+}
+
 // Attempt returns an iterator over retry attempts using the provided Backoff
 // strategy.  Each iteration yields the attempt index and the delay duration
 // before the next attempt.
@@ -78,23 +361,42 @@ func (l Linear) Delay(n int) time.Duration {
 //	}
 func Attempt(ctx context.Context, b Backoff) iter.Seq2[int, time.Duration] {
 	return func(yield func(int, time.Duration) bool) {
-		for i := 0; ; i++ {
-			if ctx.Err() != nil {
-				return
-			}
-			d := b.Delay(i)
-			if !yield(i, d) {
-				return
-			}
-
-			if d == 0 {
-				return
-			}
-			select {
-			case <-ctx.Done():
-				return
-			case <-time.Tick(d):
-			}
+		attemptLoop(ctx, b, yield)
+	}
+}
+
+// attemptLoop drives the attempt/sleep cycle shared by Attempt and
+// AttemptWithFeedback.  It resets b if it implements Resetter, then calls
+// yield with each attempt index and delay until yield returns false, b
+// implements Stopper and reports done, a 0 delay is reached, or ctx is
+// cancelled.
+func attemptLoop(ctx context.Context, b Backoff, yield func(int, time.Duration) bool) {
+	if r, ok := b.(Resetter); ok {
+		r.Reset()
+	}
+
+	for i := 0; ; i++ {
+		if ctx.Err() != nil {
+			return
+		}
+		if s, ok := b.(Stopper); ok && s.Stop(i) {
+			return
+		}
+		d := b.Delay(i)
+		if !yield(i, d) {
+			return
+		}
+
+		if d == 0 {
+			return
+		}
+
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
 		}
 	}
 }
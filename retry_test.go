@@ -2,6 +2,7 @@ package retry
 
 import (
 	"context"
+	"math/rand"
 	"testing"
 	"time"
 )
@@ -146,6 +147,215 @@ func TestDecay(t *testing.T) {
 	}
 }
 
+func TestPolynomial(t *testing.T) {
+	tests := map[string]struct {
+		i        int
+		backoff  Polynomial
+		expected []time.Duration
+	}{
+		"empty coefficients": {
+			i:        5,
+			backoff:  Polynomial{Units: time.Second},
+			expected: []time.Duration{0, 0, 0, 0, 0},
+		},
+		"degree 2": {
+			i:       3,
+			backoff: Polynomial{Units: time.Second, Coefs: []float64{0.5, 2, 3}},
+			expected: []time.Duration{
+				500 * time.Millisecond,
+				5500 * time.Millisecond,
+				16500 * time.Millisecond,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []time.Duration
+			for i := range test.i {
+				got = append(got, test.backoff.Delay(i))
+			}
+
+			if !eq(got, test.expected) {
+				t.Fatalf("got intervals %#v; expected %#v", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestBounded(t *testing.T) {
+	tests := map[string]struct {
+		i        int
+		backoff  *Bounded
+		expected []time.Duration
+	}{
+		"clamps to max": {
+			i:        4,
+			backoff:  &Bounded{B: Exponential(time.Second), Max: 5 * time.Second},
+			expected: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+		"clamps to min": {
+			i:        3,
+			backoff:  &Bounded{B: Constant(0), Min: time.Second},
+			expected: []time.Duration{time.Second, time.Second, time.Second},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []time.Duration
+			for i := range test.i {
+				got = append(got, test.backoff.Delay(i))
+			}
+
+			if !eq(got, test.expected) {
+				t.Fatalf("got intervals %#v; expected %#v", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestBoundedMaxAttempts(t *testing.T) {
+	backoff := &Bounded{B: Constant(time.Microsecond), MaxAttempts: 3}
+
+	var attempts []int
+	for i, d := range Attempt(t.Context(), backoff) {
+		attempts = append(attempts, i)
+		_ = d
+	}
+
+	if got, expected := len(attempts), 3; got != expected {
+		t.Fatalf("got %d attempts; expected %d", got, expected)
+	}
+}
+
+func TestBoundedMaxElapsed(t *testing.T) {
+	backoff := &Bounded{B: Constant(10 * time.Millisecond), MaxElapsed: 30 * time.Millisecond}
+
+	var attempts []int
+	for i, d := range Attempt(t.Context(), backoff) {
+		attempts = append(attempts, i)
+		_ = d
+	}
+
+	if got := len(attempts); got < 2 || got > 4 {
+		t.Fatalf("got %d attempts in ~30ms of 10ms delays; expected roughly 3", got)
+	}
+}
+
+func TestBoundedResetClearsMaxElapsedClock(t *testing.T) {
+	backoff := &Bounded{B: Constant(0), MaxElapsed: 20 * time.Millisecond}
+
+	backoff.Stop(0) // starts the clock
+	time.Sleep(30 * time.Millisecond)
+	if !backoff.Stop(0) {
+		t.Fatalf("got Stop(0) false after MaxElapsed passed; expected true")
+	}
+
+	backoff.Reset()
+	if backoff.Stop(0) {
+		t.Fatalf("got Stop(0) true right after Reset; expected the MaxElapsed clock to have restarted")
+	}
+}
+
+func TestConfigBackoff(t *testing.T) {
+	cfg := Config{MinBackoff: time.Second, MaxBackoff: 4 * time.Second, MaxRetries: 2}
+	backoff := cfg.Backoff()
+
+	expected := []time.Duration{time.Second, 2 * time.Second}
+	var got []time.Duration
+	for i, d := range Attempt(t.Context(), backoff) {
+		got = append(got, d)
+		_ = i
+	}
+
+	if !eq(got, expected) {
+		t.Fatalf("got intervals %#v; expected %#v", got, expected)
+	}
+}
+
+func TestCenteredJitter(t *testing.T) {
+	backoff := NewCenteredJitter(Constant(10*time.Second), 2*time.Second, rand.NewSource(1))
+
+	for i := range 5 {
+		d := backoff.Delay(i)
+		if low, high := 9*time.Second, 11*time.Second; d < low || d > high {
+			t.Fatalf("attempt %d: got delay %s; expected within [%s, %s]", i, d, low, high)
+		}
+	}
+}
+
+func TestFullJitter(t *testing.T) {
+	backoff := NewFullJitter(Constant(10*time.Second), rand.NewSource(1))
+
+	for i := range 5 {
+		d := backoff.Delay(i)
+		if d < 0 || d >= 10*time.Second {
+			t.Fatalf("attempt %d: got delay %s; expected within [0s, 10s)", i, d)
+		}
+	}
+}
+
+func TestEqualJitter(t *testing.T) {
+	backoff := NewEqualJitter(Constant(10*time.Second), rand.NewSource(1))
+
+	for i := range 5 {
+		d := backoff.Delay(i)
+		if low, high := 5*time.Second, 10*time.Second; d < low || d >= high {
+			t.Fatalf("attempt %d: got delay %s; expected within [%s, %s)", i, d, low, high)
+		}
+	}
+}
+
+func TestDecorrelatedJitter(t *testing.T) {
+	backoff := NewDecorrelatedJitter(time.Second, 30*time.Second, rand.NewSource(1))
+
+	for i := range 10 {
+		d := backoff.Delay(i)
+		if d < time.Second || d > 30*time.Second {
+			t.Fatalf("attempt %d: got delay %s; expected within [1s, 30s]", i, d)
+		}
+	}
+}
+
+func TestJitterLiteralsDoNotPanic(t *testing.T) {
+	// CenteredJitter, FullJitter, EqualJitter, and DecorrelatedJitter must
+	// stay safe to build as plain struct literals, falling back to the
+	// global math/rand source, like every other Backoff in this package.
+	backoffs := []Backoff{
+		&CenteredJitter{J: 2 * time.Second, B: Constant(10 * time.Second)},
+		&CenteredJitter{B: Constant(10 * time.Second)}, // J == 0: no jitter
+		&FullJitter{B: Constant(10 * time.Second)},
+		&EqualJitter{B: Constant(10 * time.Second)},
+		&DecorrelatedJitter{Base: time.Second, Cap: 30 * time.Second},
+	}
+
+	for _, b := range backoffs {
+		for i := range 3 {
+			_ = b.Delay(i)
+		}
+	}
+}
+
+func TestCenteredJitterZeroJReturnsUnjittered(t *testing.T) {
+	backoff := NewCenteredJitter(Constant(10*time.Second), 0, rand.NewSource(1))
+
+	if got, expected := backoff.Delay(0), 10*time.Second; got != expected {
+		t.Fatalf("got delay %s with J=0; expected unjittered %s", got, expected)
+	}
+}
+
+func TestDecorrelatedJitterSeedReproducible(t *testing.T) {
+	a := NewDecorrelatedJitter(time.Second, 30*time.Second, rand.NewSource(42))
+	b := NewDecorrelatedJitter(time.Second, 30*time.Second, rand.NewSource(42))
+
+	for i := range 10 {
+		if got, want := a.Delay(i), b.Delay(i); got != want {
+			t.Fatalf("attempt %d: got %s; expected %s to match the identically seeded instance", i, got, want)
+		}
+	}
+}
+
 func TestAttempt(t *testing.T) {
 	t.Run("constant time, 10 iterations", func(t *testing.T) {
 		backoff := Constant(10 * time.Microsecond)